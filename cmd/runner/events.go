@@ -0,0 +1,77 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/anthdm/run/proto"
+)
+
+// eventRingCapacity bounds both the replay window kept for new
+// StreamEvents subscribers and the per-subscriber backpressure buffer.
+const eventRingCapacity = 1024
+
+// eventRing is a per-deploy ring buffer of proto.Event that backs
+// StreamEvents: new subscribers get a replay of whatever's still
+// buffered at or after their sinceUnixMs, then switch to live delivery.
+type eventRing struct {
+	mu   sync.Mutex
+	buf  []*proto.Event
+	subs map[chan *proto.Event]struct{}
+}
+
+func newEventRing() *eventRing {
+	return &eventRing{
+		subs: make(map[chan *proto.Event]struct{}),
+	}
+}
+
+func (r *eventRing) publish(e *proto.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.buf = append(r.buf, e)
+	if len(r.buf) > eventRingCapacity {
+		r.buf = r.buf[len(r.buf)-eventRingCapacity:]
+	}
+
+	for ch := range r.subs {
+		select {
+		case ch <- e:
+		default:
+			// Slow subscriber: drop the oldest buffered event in its
+			// channel to make room rather than blocking the publisher.
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- e:
+			default:
+			}
+		}
+	}
+}
+
+// subscribe returns a replay of buffered events at or after sinceUnixMs
+// plus a channel that receives events published from this point on.
+// Call the returned func to unsubscribe once the caller is done.
+func (r *eventRing) subscribe(sinceUnixMs int64) ([]*proto.Event, chan *proto.Event, func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var replay []*proto.Event
+	for _, e := range r.buf {
+		if e.TsUnixMs >= sinceUnixMs {
+			replay = append(replay, e)
+		}
+	}
+
+	ch := make(chan *proto.Event, eventRingCapacity)
+	r.subs[ch] = struct{}{}
+	unsubscribe := func() {
+		r.mu.Lock()
+		delete(r.subs, ch)
+		r.mu.Unlock()
+	}
+	return replay, ch, unsubscribe
+}
@@ -0,0 +1,528 @@
+// Command runner is the wasm runtime host spawned by cmd/cli for every
+// deployed module. It listens for a proto.Runtime gRPC service on a unix
+// socket and keeps a single wazero runtime warm for the lifetime of the
+// process, rather than re-compiling the module on every request.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/anthdm/run/pkg/config"
+	"github.com/anthdm/run/pkg/signing"
+	"github.com/anthdm/run/proto"
+	"github.com/google/uuid"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// wasmMagic is the 4-byte header every real wasm module starts with.
+// Deploys that don't have it are treated as a script for the shared
+// jsInterpreter module rather than something to compile directly.
+var wasmMagic = []byte{0x00, 'a', 's', 'm'}
+
+func isWasmBinary(b []byte) bool {
+	return len(b) >= 4 && bytes.Equal(b[:4], wasmMagic)
+}
+
+func main() {
+	socketPath := flag.String("socket", "", "unix socket path to listen on")
+	configPath := flag.String("config", "config.toml", "path to config.toml; its [signing] public_keys, if set, take precedence over --keys-dir")
+	keysDir := flag.String("keys-dir", "", "directory of trusted public keys; when set, WarmUp refuses unsigned or unverifiable deploys")
+	jsInterpreter := flag.String("js-interpreter", "js.wasm", "path to the wasm JS interpreter used for deploys that aren't a compiled wasm module themselves")
+	flag.Parse()
+
+	if *socketPath == "" {
+		log.Fatal("runner: --socket is required")
+	}
+	if err := config.Parse(*configPath); err != nil {
+		log.Fatalf("runner: %v", err)
+	}
+	if err := os.RemoveAll(*socketPath); err != nil {
+		log.Fatalf("runner: removing stale socket: %v", err)
+	}
+
+	lis, err := net.Listen("unix", *socketPath)
+	if err != nil {
+		log.Fatalf("runner: listen on %s: %v", *socketPath, err)
+	}
+
+	ks, err := trustedKeystore(*keysDir)
+	if err != nil {
+		log.Fatalf("runner: %v", err)
+	}
+	srv := grpc.NewServer()
+	rs, err := newRuntimeServer(ks, *jsInterpreter)
+	if err != nil {
+		log.Fatalf("runner: %v", err)
+	}
+	proto.RegisterRuntimeServer(srv, rs)
+	log.Printf("runner: serving on %s", *socketPath)
+	if err := srv.Serve(lis); err != nil {
+		log.Fatalf("runner: serve: %v", err)
+	}
+}
+
+type runtimeServer struct {
+	proto.UnimplementedRuntimeServer
+
+	ctx      context.Context
+	runtime  wazero.Runtime
+	keystore *signing.Keystore
+
+	jsInterpreterPath string
+
+	mu            sync.Mutex
+	modules       map[string]wazero.CompiledModule
+	deploys       map[string]*deployState
+	jsInterpreter wazero.CompiledModule // lazily compiled on first use, guarded by mu
+}
+
+func newRuntimeServer(ks *signing.Keystore, jsInterpreterPath string) (*runtimeServer, error) {
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	wasi_snapshot_preview1.MustInstantiate(ctx, rt)
+	return &runtimeServer{
+		ctx:               ctx,
+		runtime:           rt,
+		keystore:          ks,
+		jsInterpreterPath: jsInterpreterPath,
+		modules:           make(map[string]wazero.CompiledModule),
+		deploys:           make(map[string]*deployState),
+	}, nil
+}
+
+// trustedKeystore builds the Keystore WarmUp verifies manifests against:
+// config.toml's [signing] public_keys when set, falling back to the
+// keysDir directory; nil (no verification) when neither is set.
+func trustedKeystore(keysDir string) (*signing.Keystore, error) {
+	if keys := config.GetSigningPublicKeys(); len(keys) > 0 {
+		return signing.NewKeystoreFromFiles(keys)
+	}
+	if keysDir != "" {
+		return signing.NewKeystore(keysDir)
+	}
+	return nil, nil
+}
+
+// compiledJSInterpreter compiles s.jsInterpreterPath on first call and
+// reuses the result for every interpreted deploy after that.
+func (s *runtimeServer) compiledJSInterpreter(ctx context.Context) (wazero.CompiledModule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.jsInterpreter != nil {
+		return s.jsInterpreter, nil
+	}
+	b, err := os.ReadFile(s.jsInterpreterPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading js interpreter %s: %w", s.jsInterpreterPath, err)
+	}
+	mod, err := s.runtime.CompileModule(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("compiling js interpreter %s: %w", s.jsInterpreterPath, err)
+	}
+	s.jsInterpreter = mod
+	return mod, nil
+}
+
+// deployState tracks a single warmed-up deploy's lifecycle and the
+// concurrency limit Scale has placed on its instantiations. state and sem
+// are written by the Pause/Resume/Stop/Scale RPCs and read by Invoke from
+// concurrent goroutines, so both are guarded by mu.
+type deployState struct {
+	endpointID string
+	lastError  string
+	startedAt  int64
+
+	// script is the deploy's own source passed to the shared jsInterpreter
+	// via "-e"; nil when the deploy is a directly-compiled wasm module.
+	script []byte
+
+	active int32 // atomic count of in-flight instantiations
+
+	mu    sync.Mutex
+	state proto.DeployState
+	sem   chan struct{} // capacity == maxInstances; nil means unbounded
+
+	events *eventRing
+}
+
+func (ds *deployState) toProto(deployID string) *proto.DeployStatus {
+	ds.mu.Lock()
+	state := ds.state
+	ds.mu.Unlock()
+	return &proto.DeployStatus{
+		DeployID:  deployID,
+		State:     state,
+		Instances: atomic.LoadInt32(&ds.active),
+		LastError: ds.lastError,
+		StartedAt: ds.startedAt,
+	}
+}
+
+func (s *runtimeServer) deployState(deployID string) (*deployState, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ds, ok := s.deploys[deployID]
+	return ds, ok
+}
+
+// WarmUp precompiles the module for a deploy so the first Invoke doesn't
+// pay compilation cost on the request path. When the runner was started
+// with trusted keys (config.toml's [signing] public_keys or --keys-dir),
+// it refuses to compile any deploy that doesn't carry a manifest
+// verifiable against one of them.
+//
+// req.WasmModule is either a compiled wasm module (sniffed by its magic
+// header) or a script meant for the shared JS interpreter. In the latter
+// case the interpreter is compiled once and shared across all deploys,
+// and the deploy's own script is kept on deployState so Invoke can pass
+// it to the interpreter via "-e" instead of the request body.
+func (s *runtimeServer) WarmUp(ctx context.Context, req *proto.WarmUpRequest) (*proto.WarmUpResponse, error) {
+	if s.keystore != nil {
+		if err := s.verifyManifest(req); err != nil {
+			return &proto.WarmUpResponse{Ready: false, Error: err.Error()}, nil
+		}
+	}
+
+	var (
+		mod    wazero.CompiledModule
+		err    error
+		script []byte
+	)
+	if isWasmBinary(req.WasmModule) {
+		mod, err = s.runtime.CompileModule(ctx, req.WasmModule)
+	} else {
+		mod, err = s.compiledJSInterpreter(ctx)
+		script = req.WasmModule
+	}
+	if err != nil {
+		return &proto.WarmUpResponse{Ready: false, Error: err.Error()}, nil
+	}
+
+	ds := &deployState{
+		endpointID: req.EndpointID,
+		state:      proto.DeployState_RUNNING,
+		startedAt:  time.Now().Unix(),
+		script:     script,
+		events:     newEventRing(),
+	}
+	s.mu.Lock()
+	s.modules[req.DeployID] = mod
+	s.deploys[req.DeployID] = ds
+	s.mu.Unlock()
+
+	ds.events.publish(&proto.Event{
+		EndpointID: req.EndpointID,
+		DeployID:   req.DeployID,
+		Kind:       proto.EventKind_DEPLOY_CREATED,
+		TsUnixMs:   time.Now().UnixMilli(),
+	})
+
+	return &proto.WarmUpResponse{Ready: true}, nil
+}
+
+func (s *runtimeServer) verifyManifest(req *proto.WarmUpRequest) error {
+	manifest := req.GetManifest()
+	if manifest == nil {
+		return fmt.Errorf("runner: deploy %s has no manifest, refusing to run against a keyed runner", req.DeployID)
+	}
+	keyID := manifest.GetSignature().GetKeyID()
+	pub, err := s.keystore.Load(keyID)
+	if err != nil {
+		return fmt.Errorf("runner: loading key %q: %w", keyID, err)
+	}
+	return signing.Verify(manifest, req.WasmModule, pub)
+}
+
+// Invoke instantiates the deploy's compiled module and streams its stdout
+// back to the host as it's produced, instead of buffering the whole run
+// and parsing the last line for a status code. A paused or stopped deploy
+// is rejected immediately with a 503 rather than being instantiated, and
+// Scale's instance cap is enforced as a semaphore around instantiation.
+func (s *runtimeServer) Invoke(req *proto.HTTPRequest, stream proto.Runtime_InvokeServer) error {
+	s.mu.Lock()
+	mod, ok := s.modules[req.ActiveDeployID]
+	ds := s.deploys[req.ActiveDeployID]
+	s.mu.Unlock()
+	if ds == nil {
+		return fmt.Errorf("runner: no warmed-up module for deploy %s", req.ActiveDeployID)
+	}
+
+	requestID := req.ID
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	started := time.Now()
+	ds.publishEvent(proto.EventKind_REQUEST_START, requestID, nil)
+
+	ds.mu.Lock()
+	state := ds.state
+	sem := ds.sem
+	ds.mu.Unlock()
+
+	if state == proto.DeployState_PAUSED || state == proto.DeployState_STOPPED {
+		ds.publishEvent(proto.EventKind_REQUEST_END, requestID, requestEndPayload(http.StatusServiceUnavailable, time.Since(started)))
+		return stream.Send(&proto.HTTPResponseChunk{StatusCode: http.StatusServiceUnavailable})
+	}
+	if !ok {
+		ds.publishEvent(proto.EventKind_REQUEST_END, requestID, requestEndPayload(http.StatusInternalServerError, time.Since(started)))
+		return fmt.Errorf("runner: no warmed-up module for deploy %s", req.ActiveDeployID)
+	}
+
+	if sem != nil {
+		sem <- struct{}{}
+		defer func() { <-sem }()
+	}
+	atomic.AddInt32(&ds.active, 1)
+	defer atomic.AddInt32(&ds.active, -1)
+
+	out := &statusParsingWriter{out: &streamWriter{stream: stream}}
+	modConfigBuilder := wazero.NewModuleConfig().
+		WithStdout(io.MultiWriter(out, ds.eventWriter(proto.EventKind_STDOUT, requestID))).
+		WithStdin(bytes.NewReader(req.Body)).
+		WithStderr(io.MultiWriter(os.Stderr, ds.eventWriter(proto.EventKind_STDERR, requestID)))
+	if ds.script != nil {
+		modConfigBuilder = modConfigBuilder.WithArgs("", "-e", string(ds.script))
+	}
+
+	if _, err := s.runtime.InstantiateModule(s.ctx, mod, modConfigBuilder); err != nil {
+		ds.publishEvent(proto.EventKind_REQUEST_END, requestID, requestEndPayload(http.StatusInternalServerError, time.Since(started)))
+		return err
+	}
+	status, err := out.finish()
+	if err != nil {
+		ds.publishEvent(proto.EventKind_REQUEST_END, requestID, requestEndPayload(http.StatusInternalServerError, time.Since(started)))
+		return err
+	}
+	ds.publishEvent(proto.EventKind_REQUEST_END, requestID, requestEndPayload(status, time.Since(started)))
+	return stream.Send(&proto.HTTPResponseChunk{StatusCode: int32(status)})
+}
+
+func requestEndPayload(statusCode int, latency time.Duration) []byte {
+	b, _ := json.Marshal(struct {
+		StatusCode int   `json:"statusCode"`
+		LatencyMs  int64 `json:"latencyMs"`
+	}{statusCode, latency.Milliseconds()})
+	return b
+}
+
+func (ds *deployState) publishEvent(kind proto.EventKind, requestID string, payload []byte) {
+	ds.events.publish(&proto.Event{
+		EndpointID: ds.endpointID,
+		Kind:       kind,
+		TsUnixMs:   time.Now().UnixMilli(),
+		RequestID:  requestID,
+		Payload:    payload,
+	})
+}
+
+// eventWriter tees a wasm instance's stdout/stderr into ds's event ring
+// without buffering a full line, so STDOUT/STDERR events stay close to
+// real time even for long-running instances.
+func (ds *deployState) eventWriter(kind proto.EventKind, requestID string) io.Writer {
+	return writerFunc(func(p []byte) (int, error) {
+		ds.publishEvent(kind, requestID, append([]byte(nil), p...))
+		return len(p), nil
+	})
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+func (s *runtimeServer) Shutdown(ctx context.Context, req *proto.ShutdownRequest) (*emptypb.Empty, error) {
+	s.mu.Lock()
+	delete(s.modules, req.DeployID)
+	delete(s.deploys, req.DeployID)
+	s.mu.Unlock()
+	return &emptypb.Empty{}, nil
+}
+
+func (s *runtimeServer) PauseDeploy(ctx context.Context, req *proto.PauseDeployRequest) (*proto.DeployStatus, error) {
+	ds, ok := s.deployState(req.DeployID)
+	if !ok {
+		return nil, fmt.Errorf("runner: unknown deploy %s", req.DeployID)
+	}
+	ds.mu.Lock()
+	ds.state = proto.DeployState_PAUSED
+	ds.mu.Unlock()
+	return ds.toProto(req.DeployID), nil
+}
+
+func (s *runtimeServer) ResumeDeploy(ctx context.Context, req *proto.ResumeDeployRequest) (*proto.DeployStatus, error) {
+	ds, ok := s.deployState(req.DeployID)
+	if !ok {
+		return nil, fmt.Errorf("runner: unknown deploy %s", req.DeployID)
+	}
+	ds.mu.Lock()
+	if ds.state == proto.DeployState_STOPPED {
+		ds.mu.Unlock()
+		return nil, fmt.Errorf("runner: deploy %s is stopped and must be warmed up again", req.DeployID)
+	}
+	ds.state = proto.DeployState_RUNNING
+	ds.mu.Unlock()
+	return ds.toProto(req.DeployID), nil
+}
+
+// StopDeploy marks a deploy terminal and frees its compiled module; unlike
+// Pause, a stopped deploy can't be resumed and must be warmed up again.
+func (s *runtimeServer) StopDeploy(ctx context.Context, req *proto.StopDeployRequest) (*proto.DeployStatus, error) {
+	ds, ok := s.deployState(req.DeployID)
+	if !ok {
+		return nil, fmt.Errorf("runner: unknown deploy %s", req.DeployID)
+	}
+	ds.mu.Lock()
+	ds.state = proto.DeployState_STOPPED
+	ds.mu.Unlock()
+	s.mu.Lock()
+	delete(s.modules, req.DeployID)
+	s.mu.Unlock()
+	return ds.toProto(req.DeployID), nil
+}
+
+// ScaleDeploy caps the number of concurrently cached CompiledModule
+// instantiations a deploy may have in flight at once.
+func (s *runtimeServer) ScaleDeploy(ctx context.Context, req *proto.ScaleDeployRequest) (*proto.DeployStatus, error) {
+	ds, ok := s.deployState(req.DeployID)
+	if !ok {
+		return nil, fmt.Errorf("runner: unknown deploy %s", req.DeployID)
+	}
+	ds.mu.Lock()
+	if req.MaxInstances > 0 {
+		ds.sem = make(chan struct{}, req.MaxInstances)
+	} else {
+		ds.sem = nil
+	}
+	ds.mu.Unlock()
+	return ds.toProto(req.DeployID), nil
+}
+
+// StreamEvents tails a deploy's request/stdout/stderr/lifecycle feed. On
+// connect it replays whatever's still in the ring buffer at or after
+// req.SinceUnixMs, then switches to live delivery; a slow client has its
+// oldest buffered event dropped rather than blocking the publisher.
+func (s *runtimeServer) StreamEvents(req *proto.EventsRequest, stream proto.Runtime_StreamEventsServer) error {
+	ds, ok := s.deployState(req.DeployID)
+	if !ok {
+		return fmt.Errorf("runner: unknown deploy %s", req.DeployID)
+	}
+
+	replay, ch, unsubscribe := ds.events.subscribe(req.SinceUnixMs)
+	defer unsubscribe()
+
+	matches := func(e *proto.Event) bool {
+		return req.KindFilter == proto.EventKind_UNSPECIFIED || e.Kind == req.KindFilter
+	}
+
+	for _, e := range replay {
+		if !matches(e) {
+			continue
+		}
+		if err := stream.Send(e); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case e := <-ch:
+			if !matches(e) {
+				continue
+			}
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// streamWriter adapts the wasm module's stdout into a sequence of
+// HTTPResponseChunk messages on the Invoke stream.
+type streamWriter struct {
+	stream proto.Runtime_InvokeServer
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&proto.HTTPResponseChunk{Data: append([]byte(nil), p...)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// statusParsingWriter forwards complete lines to out as they arrive, and
+// holds back the trailing, possibly-incomplete line. That last line is the
+// app's "<body>|<statusCode>" trailer; finish parses it once the instance
+// has exited and forwards the body portion, so the response status reflects
+// what the app actually returned instead of a hardcoded constant.
+type statusParsingWriter struct {
+	out     io.Writer
+	pending []byte
+}
+
+func (w *statusParsingWriter) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+	i := bytes.LastIndexByte(w.pending, '\n')
+	if i < 0 {
+		return len(p), nil
+	}
+	// The buffer's last line - the one that might be the trailer - runs
+	// from the newline before i to the end, so it has to stay in pending
+	// even when it's already newline-terminated; only flush what's
+	// before it.
+	flushThrough := i + 1
+	if i == len(w.pending)-1 {
+		if prev := bytes.LastIndexByte(w.pending[:i], '\n'); prev >= 0 {
+			flushThrough = prev + 1
+		} else {
+			flushThrough = 0
+		}
+	}
+	if flushThrough == 0 {
+		return len(p), nil
+	}
+	if _, err := w.out.Write(w.pending[:flushThrough]); err != nil {
+		return 0, err
+	}
+	w.pending = w.pending[flushThrough:]
+	return len(p), nil
+}
+
+// finish parses the trailing "<body>|<statusCode>" line left in pending,
+// writes the body portion to out, and returns the status code. A trailer
+// that's missing or malformed falls back to http.StatusOK so a plain-text
+// response body isn't swallowed.
+func (w *statusParsingWriter) finish() (int, error) {
+	last := strings.TrimSuffix(string(w.pending), "\n")
+	body := last
+	status := http.StatusOK
+	if i := strings.LastIndexByte(last, '|'); i >= 0 {
+		if parsed, err := strconv.Atoi(last[i+1:]); err == nil {
+			body = last[:i]
+			status = parsed
+		}
+	}
+	if body != "" {
+		if _, err := w.out.Write([]byte(body)); err != nil {
+			return 0, err
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStatusParsingWriterSplitsTrailer(t *testing.T) {
+	cases := []struct {
+		name       string
+		chunks     []string
+		wantOut    string
+		wantStatus int
+	}{
+		{
+			name:       "single write with trailing newline",
+			chunks:     []string{"hello world\nok|404\n"},
+			wantOut:    "hello world\nok",
+			wantStatus: 404,
+		},
+		{
+			name:       "trailer split across writes",
+			chunks:     []string{"line1\n", "line2\n", "body|200\n"},
+			wantOut:    "line1\nline2\nbody",
+			wantStatus: 200,
+		},
+		{
+			name:       "trailer without newline",
+			chunks:     []string{"ok|201"},
+			wantOut:    "ok",
+			wantStatus: 201,
+		},
+		{
+			name:       "no trailer falls back to OK",
+			chunks:     []string{"just some output\n"},
+			wantOut:    "just some output",
+			wantStatus: 200,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := &statusParsingWriter{out: &out}
+			for _, c := range tc.chunks {
+				if _, err := w.Write([]byte(c)); err != nil {
+					t.Fatalf("Write: %v", err)
+				}
+			}
+			status, err := w.finish()
+			if err != nil {
+				t.Fatalf("finish: %v", err)
+			}
+			if status != tc.wantStatus {
+				t.Fatalf("status = %d, want %d", status, tc.wantStatus)
+			}
+			if out.String() != tc.wantOut {
+				t.Fatalf("out = %q, want %q", out.String(), tc.wantOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package api
+
+import "github.com/google/uuid"
+
+// DeployState is the control plane's view of a deploy's lifecycle. It
+// mirrors proto.DeployState but is kept as its own type so pkg/api has no
+// generated-proto dependency.
+type DeployState string
+
+const (
+	DeployStateCreated DeployState = "CREATED"
+	DeployStateRunning DeployState = "RUNNING"
+	DeployStatePaused  DeployState = "PAUSED"
+	DeployStateStopped DeployState = "STOPPED"
+	DeployStateFailed  DeployState = "FAILED"
+)
+
+// DeployStatus is returned by PauseDeploy, ResumeDeploy, StopDeploy and
+// ScaleDeploy so callers can see the lifecycle transition take effect.
+type DeployStatus struct {
+	DeployID  uuid.UUID   `json:"deployID"`
+	State     DeployState `json:"state"`
+	Instances int32       `json:"instances"`
+	LastError string      `json:"lastError,omitempty"`
+	StartedAt int64       `json:"startedAt"`
+}
+
+// CreateScaleParams sets a running deploy's instance cap. There's no
+// MinInstances: the runner instantiates the wasm module fresh per
+// request rather than keeping a pool of running instances, so there's
+// nothing to pre-warm a minimum bound into.
+type CreateScaleParams struct {
+	MaxInstances int32 `json:"maxInstances"`
+}
@@ -0,0 +1,48 @@
+package api
+
+import "github.com/google/uuid"
+
+// Endpoint is a named deploy target: a runtime, an environment and
+// whichever deploy is currently serving traffic.
+type Endpoint struct {
+	ID             uuid.UUID         `json:"id"`
+	Name           string            `json:"name"`
+	Runtime        string            `json:"runtime"`
+	Environment    map[string]string `json:"environment,omitempty"`
+	ActiveDeployID uuid.UUID         `json:"activeDeployID,omitempty"`
+	CreatedAt      int64             `json:"createdAt"`
+}
+
+// CreateEndpointParams reserves a name and runtime for an endpoint; it
+// doesn't deploy a module on its own.
+type CreateEndpointParams struct {
+	Name        string            `json:"name"`
+	Runtime     string            `json:"runtime"`
+	Environment map[string]string `json:"environment,omitempty"`
+}
+
+// Deploy is a single wasm upload bound to an endpoint.
+type Deploy struct {
+	ID         uuid.UUID   `json:"id"`
+	EndpointID uuid.UUID   `json:"endpointID"`
+	State      DeployState `json:"state"`
+	CreatedAt  int64       `json:"createdAt"`
+}
+
+// CreateDeployParams carries the signed manifest alongside the wasm
+// upload it pins. DeployID is the ID the manifest was signed under, so
+// the control plane assigns the deploy that same ID rather than minting
+// an unrelated one the runner's manifest check could never match.
+// ManifestJSON is the raw signed manifest, forwarded as-is so the runner
+// that ultimately warms up the module can verify it against the exact
+// bytes that were signed.
+type CreateDeployParams struct {
+	DeployID     uuid.UUID
+	ManifestJSON []byte
+}
+
+// CreateRollbackParams points an endpoint's active deploy at one it
+// previously served.
+type CreateRollbackParams struct {
+	DeployID uuid.UUID `json:"deployID"`
+}
@@ -0,0 +1,29 @@
+package api
+
+import "encoding/json"
+
+// EventKind mirrors proto.EventKind as a string so pkg/api stays free of
+// a generated-proto dependency, matching DeployState.
+type EventKind string
+
+const (
+	EventKindRequestStart  EventKind = "REQUEST_START"
+	EventKindRequestEnd    EventKind = "REQUEST_END"
+	EventKindStdout        EventKind = "STDOUT"
+	EventKindStderr        EventKind = "STDERR"
+	EventKindInstanceStart EventKind = "INSTANCE_START"
+	EventKindInstanceExit  EventKind = "INSTANCE_EXIT"
+	EventKindDeployCreated EventKind = "DEPLOY_CREATED"
+	EventKindRollback      EventKind = "ROLLBACK"
+)
+
+// Event is one entry on a deploy's log/event feed, as delivered to CLI
+// and other StreamLogs callers over the control plane's HTTP API.
+type Event struct {
+	EndpointID string          `json:"endpointID"`
+	DeployID   string          `json:"deployID"`
+	Kind       EventKind       `json:"kind"`
+	TsUnixMs   int64           `json:"tsUnixMs"`
+	RequestID  string          `json:"requestID,omitempty"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
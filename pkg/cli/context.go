@@ -0,0 +1,49 @@
+// Package cli assembles the `run` command tree on top of cobra. Each
+// command group (endpoint, deploy, run, keys, logs, sign) lives in its
+// own file and exposes a newXCommand() *cobra.Command constructor; the
+// root command's PersistentPreRunE is the only place config.toml gets
+// parsed and client.Client gets constructed, so command RunE funcs just
+// pull both off the Context already sitting on cmd.Context().
+package cli
+
+import (
+	"context"
+
+	"github.com/anthdm/run/pkg/client"
+	"github.com/anthdm/run/pkg/signing"
+	"github.com/spf13/cobra"
+)
+
+// Context carries everything a command's RunE needs once the root
+// command has parsed config.toml and dialed the control plane.
+type Context struct {
+	Client *client.Client
+
+	// KeysDir is the directory `run keys add/list` manage their trusted
+	// keys in. It stays directory-backed even when Keystore is built
+	// from config.toml instead, since keys add/list are how an operator
+	// populates the files public_keys then points at.
+	KeysDir string
+
+	// Keystore is what `deploy` actually verifies a manifest against:
+	// config.toml's [signing] public_keys when set, falling back to the
+	// KeysDir directory otherwise.
+	Keystore *signing.Keystore
+}
+
+type contextKey struct{}
+
+func withContext(ctx context.Context, cliCtx *Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, cliCtx)
+}
+
+// fromCommand retrieves the Context the root's PersistentPreRunE stored
+// on cmd. It panics if called outside that flow, which would be a bug in
+// this package rather than something a command needs to handle.
+func fromCommand(cmd *cobra.Command) *Context {
+	cliCtx, ok := cmd.Context().Value(contextKey{}).(*Context)
+	if !ok {
+		panic("cli: command run without a Context; is it wired under NewRootCommand?")
+	}
+	return cliCtx
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/anthdm/run/pkg/api"
+	"github.com/anthdm/run/pkg/config"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// newDeployCommand is `deploy <endpointID> <path/to/app.wasm>`.
+func newDeployCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deploy <endpointID> <path/to/app.wasm>",
+		Short: "Deploy an app to the cloud",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := fromCommand(cmd)
+
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid endpoint id given: %s", args[0])
+			}
+			b, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			manifest, manifestJSON, err := verifyManifestForDeploy(cliCtx.Keystore, args[1], b)
+			if err != nil {
+				return err
+			}
+			deployID, err := uuid.Parse(manifest.GetDeployID())
+			if err != nil {
+				return fmt.Errorf("manifest has invalid deployID %q: %w", manifest.GetDeployID(), err)
+			}
+			deploy, err := cliCtx.Client.CreateDeploy(id, bytes.NewReader(b), api.CreateDeployParams{
+				DeployID:     deployID,
+				ManifestJSON: manifestJSON,
+			})
+			if err != nil {
+				return err
+			}
+			if err := printJSON(deploy); err != nil {
+				return err
+			}
+			fmt.Println()
+			fmt.Printf("deploy is live on: %s/%s\n", config.GetWasmUrl(), deploy.EndpointID)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/anthdm/run/pkg/api"
+	"github.com/anthdm/run/pkg/types"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// newEndpointCommand assembles `endpoint create|list|get|rollback|pause|
+// resume|stop|scale`.
+func newEndpointCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "endpoint",
+		Short: "Manage endpoints and their deploys",
+	}
+	cmd.AddCommand(
+		newEndpointCreateCommand(),
+		newEndpointListCommand(),
+		newEndpointGetCommand(),
+		newEndpointRollbackCommand(),
+		newEndpointPauseCommand(),
+		newEndpointResumeCommand(),
+		newEndpointStopCommand(),
+		newEndpointScaleCommand(),
+	)
+	return cmd
+}
+
+func newEndpointCreateCommand() *cobra.Command {
+	var runtimeFlag string
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new endpoint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !types.ValidRuntime(runtimeFlag) {
+				return fmt.Errorf("invalid --runtime %q", runtimeFlag)
+			}
+			env, err := makeEnvMap(envFlag)
+			if err != nil {
+				return err
+			}
+			params := api.CreateEndpointParams{
+				Runtime:     runtimeFlag,
+				Name:        args[0],
+				Environment: env,
+			}
+			endpoint, err := fromCommand(cmd).Client.CreateEndpoint(params)
+			if err != nil {
+				return err
+			}
+			return printJSON(endpoint)
+		},
+	}
+	cmd.Flags().StringVar(&runtimeFlag, "runtime", "", "runtime of your application [go or js]")
+	return cmd
+}
+
+func newEndpointListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List current endpoints",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpoints, err := fromCommand(cmd).Client.ListEndpoints()
+			if err != nil {
+				return err
+			}
+			return printJSON(endpoints)
+		},
+	}
+}
+
+func newEndpointGetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <endpointID>",
+		Short: "Show a single endpoint",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpointID, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+			endpoint, err := fromCommand(cmd).Client.GetEndpoint(endpointID)
+			if err != nil {
+				return err
+			}
+			return printJSON(endpoint)
+		},
+	}
+}
+
+func newEndpointRollbackCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback <endpointID> <deployID>",
+		Short: "Roll an endpoint back to a previous deploy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpointID, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+			deployID, err := uuid.Parse(args[1])
+			if err != nil {
+				return err
+			}
+			resp, err := fromCommand(cmd).Client.RollbackEndpoint(endpointID, api.CreateRollbackParams{DeployID: deployID})
+			if err != nil {
+				return err
+			}
+			return printJSON(resp)
+		},
+	}
+}
+
+func newEndpointPauseCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pause <endpointID> <deployID>",
+		Short: "Pause a deploy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpointID, deployID, err := parseEndpointDeployIDs(args)
+			if err != nil {
+				return err
+			}
+			status, err := fromCommand(cmd).Client.PauseDeploy(endpointID, deployID)
+			if err != nil {
+				return err
+			}
+			return printJSON(status)
+		},
+	}
+}
+
+func newEndpointResumeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "resume <endpointID> <deployID>",
+		Short: "Resume a paused deploy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpointID, deployID, err := parseEndpointDeployIDs(args)
+			if err != nil {
+				return err
+			}
+			status, err := fromCommand(cmd).Client.ResumeDeploy(endpointID, deployID)
+			if err != nil {
+				return err
+			}
+			return printJSON(status)
+		},
+	}
+}
+
+func newEndpointStopCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stop <endpointID> <deployID>",
+		Short: "Stop a deploy",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpointID, deployID, err := parseEndpointDeployIDs(args)
+			if err != nil {
+				return err
+			}
+			status, err := fromCommand(cmd).Client.StopDeploy(endpointID, deployID)
+			if err != nil {
+				return err
+			}
+			return printJSON(status)
+		},
+	}
+}
+
+func newEndpointScaleCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "scale <endpointID> <deployID> <max>",
+		Short: "Cap a deploy's concurrent instances",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpointID, deployID, err := parseEndpointDeployIDs(args)
+			if err != nil {
+				return err
+			}
+			max, err := parseInt32(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid max instances %q: %w", args[2], err)
+			}
+			params := api.CreateScaleParams{MaxInstances: max}
+			status, err := fromCommand(cmd).Client.ScaleDeploy(endpointID, deployID, params)
+			if err != nil {
+				return err
+			}
+			return printJSON(status)
+		},
+	}
+}
+
+// parseEndpointDeployIDs is shared by every lifecycle subcommand, which
+// all start with the same <endpointID> <deployID> pair.
+func parseEndpointDeployIDs(args []string) (endpointID, deployID uuid.UUID, err error) {
+	endpointID, err = uuid.Parse(args[0])
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	deployID, err = uuid.Parse(args[1])
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+	return endpointID, deployID, nil
+}
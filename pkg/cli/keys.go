@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/anthdm/run/pkg/signing"
+	"github.com/spf13/cobra"
+)
+
+// newKeysCommand assembles `keys add|list`.
+func newKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage trusted signing keys",
+	}
+	cmd.AddCommand(newKeysAddCommand(), newKeysListCommand())
+	return cmd
+}
+
+func newKeysAddCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <keyID> <path/to/pub.pem>",
+		Short: "Trust a public key",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := fromCommand(cmd)
+
+			pub, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			ks, err := signing.NewKeystore(cliCtx.KeysDir)
+			if err != nil {
+				return err
+			}
+			if err := ks.Add(args[0], pub); err != nil {
+				return err
+			}
+			fmt.Printf("added key %q to %s\n", args[0], cliCtx.KeysDir)
+			return nil
+		},
+	}
+}
+
+func newKeysListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List trusted keys",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, err := signing.NewKeystore(fromCommand(cmd).KeysDir)
+			if err != nil {
+				return err
+			}
+			ids, err := ks.List()
+			if err != nil {
+				return err
+			}
+			for _, id := range ids {
+				fmt.Println(id)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/anthdm/run/pkg/api"
+	"github.com/anthdm/run/pkg/client"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// newLogsCommand is `logs <endpointID> [--deploy <id>] [--follow] [--since 5m]`.
+func newLogsCommand() *cobra.Command {
+	var (
+		deployIDFlag string
+		kindFlag     string
+		follow       bool
+		since        string
+	)
+	cmd := &cobra.Command{
+		Use:   "logs <endpointID>",
+		Short: "Tail request/stdout/stderr events",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			endpointID, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+
+			var deployID uuid.UUID
+			if deployIDFlag != "" {
+				deployID, err = uuid.Parse(deployIDFlag)
+				if err != nil {
+					return err
+				}
+			}
+
+			var sinceTime time.Time
+			if since != "" {
+				d, err := time.ParseDuration(since)
+				if err != nil {
+					return fmt.Errorf("invalid --since %q: %w", since, err)
+				}
+				sinceTime = time.Now().Add(-d)
+			}
+
+			stream, err := fromCommand(cmd).Client.StreamLogs(cmd.Context(), endpointID, client.StreamLogsParams{
+				DeployID: deployID,
+				Kind:     api.EventKind(kindFlag),
+				Since:    sinceTime,
+				Follow:   follow,
+			})
+			if err != nil {
+				return err
+			}
+			defer stream.Close()
+
+			for {
+				event, err := stream.Recv()
+				if err != nil {
+					if err == io.EOF {
+						return nil
+					}
+					return err
+				}
+				fmt.Printf("%s %-8s %s %s\n", time.UnixMilli(event.TsUnixMs).Format(time.RFC3339), event.Kind, event.RequestID, event.Payload)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&deployIDFlag, "deploy", "", "only stream events for this deploy")
+	cmd.Flags().StringVar(&kindFlag, "kind", "", "only stream events of this kind, e.g. STDOUT, REQUEST_END")
+	cmd.Flags().BoolVar(&follow, "follow", false, "keep streaming new events after the replay window")
+	cmd.Flags().StringVar(&since, "since", "", "replay window, e.g. 5m, 1h")
+	return cmd
+}
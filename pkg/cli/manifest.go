@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/anthdm/run/pkg/signing"
+	"github.com/anthdm/run/proto"
+)
+
+// manifestPath is where `sign` writes a deploy's signed manifest and
+// where `deploy` expects to find it, alongside the wasm module itself.
+func manifestPath(wasmPath string) string {
+	return wasmPath + ".manifest.json"
+}
+
+// verifyManifestForDeploy refuses the deploy unless wasmPath has a signed
+// manifest sitting next to it that verifies against a key in ks. This is
+// a client-side pre-flight check only: the runner that ultimately warms
+// up the module re-verifies the manifest it's handed before compiling,
+// since the manifest travels with the deploy rather than the CLI's
+// trust of it. It returns the parsed manifest and its raw bytes so the
+// caller can forward both to CreateDeploy, since sign is where the
+// deploy's ID was actually minted and deploy must reuse it rather than
+// let the control plane assign an unrelated one.
+func verifyManifestForDeploy(ks *signing.Keystore, wasmPath string, wasm []byte) (*proto.DeployManifest, []byte, error) {
+	mb, err := os.ReadFile(manifestPath(wasmPath))
+	if err != nil {
+		return nil, nil, fmt.Errorf("deploy requires a signed manifest; run `run sign %s --key k.pem --endpoint <id>` first: %w", wasmPath, err)
+	}
+	var manifest proto.DeployManifest
+	if err := json.Unmarshal(mb, &manifest); err != nil {
+		return nil, nil, fmt.Errorf("parsing manifest %s: %w", manifestPath(wasmPath), err)
+	}
+	pub, err := ks.Load(manifest.GetSignature().GetKeyID())
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading key %q: %w", manifest.GetSignature().GetKeyID(), err)
+	}
+	if err := signing.Verify(&manifest, wasm, pub); err != nil {
+		return nil, nil, err
+	}
+	return &manifest, mb, nil
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anthdm/run/pkg/client"
+	"github.com/anthdm/run/pkg/config"
+	"github.com/anthdm/run/pkg/signing"
+	"github.com/spf13/cobra"
+)
+
+// CommandRegistrar lets a Go module imported purely for side effect
+// (e.g. a cloud-specific deploy target) add its own subcommand to the
+// tree without this package knowing about it ahead of time.
+type CommandRegistrar interface {
+	RegisterCommand(root *cobra.Command)
+}
+
+var registrars []CommandRegistrar
+
+// Register adds r to the registrars NewRootCommand consults when
+// assembling the tree. Call it from an init() in the registering package.
+func Register(r CommandRegistrar) {
+	registrars = append(registrars, r)
+}
+
+var (
+	configFile string
+	envFlag    []string
+)
+
+// NewRootCommand builds the `run` command tree. --config and --env are
+// persistent flags parsed once in PersistentPreRunE, which is also
+// where config.toml gets read and the control-plane client.Client gets
+// constructed; everything else is a per-command flag owned by that
+// command's own file. Shell completions (`run completion bash|zsh|fish`)
+// come from cobra's default completion command, added automatically
+// once the root has subcommands.
+func NewRootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:           "run",
+		Short:         "Run any application in the cloud and on the edge",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.Parse(configFile); err != nil {
+				return err
+			}
+			keysDir := defaultKeysDir()
+			ks, err := trustedKeystore(keysDir)
+			if err != nil {
+				return err
+			}
+			cliCtx := &Context{
+				Client:   client.New(client.NewConfig().WithURL(config.GetApiUrl())),
+				KeysDir:  keysDir,
+				Keystore: ks,
+			}
+			cmd.SetContext(withContext(cmd.Context(), cliCtx))
+			return nil
+		},
+	}
+	root.PersistentFlags().StringVar(&configFile, "config", "config.toml", "path to config.toml")
+	root.PersistentFlags().StringArrayVar(&envFlag, "env", nil, "set an environment variable [--env foo=bar]")
+
+	root.AddCommand(
+		newEndpointCommand(),
+		newDeployCommand(),
+		newRunCommand(),
+		newSignCommand(),
+		newKeysCommand(),
+		newLogsCommand(),
+	)
+	for _, r := range registrars {
+		r.RegisterCommand(root)
+	}
+	return root
+}
+
+// defaultKeysDir is where `run keys add/list` keep trusted public keys.
+func defaultKeysDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = "."
+	}
+	return filepath.Join(home, ".run", "keys")
+}
+
+// trustedKeystore builds the Keystore `deploy` verifies manifests
+// against: config.toml's [signing] public_keys when set, falling back
+// to the keysDir directory `run keys add/list` manage.
+func trustedKeystore(keysDir string) (*signing.Keystore, error) {
+	if keys := config.GetSigningPublicKeys(); len(keys) > 0 {
+		return signing.NewKeystoreFromFiles(keys)
+	}
+	return signing.NewKeystore(keysDir)
+}
+
+// makeEnvMap turns --env foo=bar --env name=bob into a map, used by
+// both `endpoint create` and `sign` to build a deploy's environment.
+func makeEnvMap(list []string) (map[string]string, error) {
+	m := make(map[string]string, len(list))
+	for _, value := range list {
+		parts := strings.Split(value, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("env arguments need to be in the format of --env foo=bar --env name=bob")
+		}
+		m[parts[0]] = parts[1]
+	}
+	return m, nil
+}
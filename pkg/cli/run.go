@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/anthdm/run/pkg/client"
+	"github.com/anthdm/run/proto"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// newRunCommand is `run run <path/to/app.wasm(js)>`. It spawns a
+// cmd/runner process for the wasm module and proxies HTTP requests to
+// it over the proto.Runtime service on a unix socket, instead of
+// instantiating the module in-process and scraping the last line of its
+// stdout for a status code.
+func newRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <path/to/app.wasm(js)>",
+		Short: "Test your application",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			b, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			socketPath := filepath.Join(os.TempDir(), fmt.Sprintf("run-%s.sock", uuid.NewString()))
+			runner := exec.Command("runner", "--socket", socketPath)
+			runner.Stderr = os.Stderr
+			if err := runner.Start(); err != nil {
+				return fmt.Errorf("starting runner: %w", err)
+			}
+			defer runner.Process.Kill()
+
+			if err := client.WaitForSocket(socketPath, 5*time.Second); err != nil {
+				return err
+			}
+
+			rc, err := client.DialRuntime(ctx, socketPath)
+			if err != nil {
+				return err
+			}
+			defer rc.Close()
+
+			deployID := uuid.NewString()
+			warm, err := rc.WarmUp(ctx, &proto.WarmUpRequest{DeployID: deployID, WasmModule: b})
+			if err != nil || !warm.Ready {
+				return fmt.Errorf("warming up runner: %w (runner error: %s)", err, warm.GetError())
+			}
+
+			return http.ListenAndServe(":3000", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/favicon.ico" {
+					return
+				}
+				body, _ := io.ReadAll(r.Body)
+				stream, err := rc.Invoke(ctx, &proto.HTTPRequest{
+					Body:           body,
+					Method:         r.Method,
+					URL:            r.URL.String(),
+					ActiveDeployID: deployID,
+				})
+				if err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(err.Error()))
+					return
+				}
+				// The status code arrives on the stream's final chunk, so the
+				// body is held until the stream ends and written after it.
+				var respBody []byte
+				status := http.StatusOK
+				for {
+					chunk, err := stream.Recv()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						w.WriteHeader(http.StatusInternalServerError)
+						w.Write([]byte(err.Error()))
+						return
+					}
+					respBody = append(respBody, chunk.Data...)
+					if chunk.StatusCode != 0 {
+						status = int(chunk.StatusCode)
+					}
+				}
+				w.WriteHeader(status)
+				w.Write(respBody)
+			}))
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/anthdm/run/pkg/signing"
+	"github.com/anthdm/run/proto"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// newSignCommand is `sign <path/to/wasm> --key k.pem --endpoint <id>`.
+func newSignCommand() *cobra.Command {
+	var (
+		keyPath    string
+		endpointID string
+		runtimeVal string
+	)
+	cmd := &cobra.Command{
+		Use:   "sign <path/to/app.wasm>",
+		Short: "Sign a wasm module for deploy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wasm, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			priv, err := signing.LoadPrivateKey(keyPath)
+			if err != nil {
+				return err
+			}
+			env, err := makeEnvMap(envFlag)
+			if err != nil {
+				return err
+			}
+
+			manifest := &proto.DeployManifest{
+				EndpointID: endpointID,
+				DeployID:   uuid.NewString(),
+				Runtime:    runtimeVal,
+				Env:        env,
+				CreatedAt:  time.Now().Unix(),
+			}
+			signing.Sign(manifest, wasm, filepath.Base(keyPath), priv)
+
+			b, err := json.MarshalIndent(manifest, "", "    ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(manifestPath(args[0]), b, 0o644); err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+			fmt.Println()
+			fmt.Printf("wrote %s\n", manifestPath(args[0]))
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&keyPath, "key", "", "path to the private key to sign with (required)")
+	cmd.Flags().StringVar(&endpointID, "endpoint", "", "endpoint this deploy targets (required)")
+	cmd.Flags().StringVar(&runtimeVal, "runtime", "", "runtime of your application [go or js]")
+	cmd.MarkFlagRequired("key")
+	cmd.MarkFlagRequired("endpoint")
+	return cmd
+}
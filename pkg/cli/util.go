@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// printJSON is how every command prints the control plane's response.
+func printJSON(v any) error {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(b))
+	return nil
+}
+
+func parseInt32(s string) (int32, error) {
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int32(n), nil
+}
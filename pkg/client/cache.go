@@ -0,0 +1,90 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/anthdm/run/pkg/api"
+	"github.com/anthdm/run/pkg/client/cache"
+	"github.com/google/uuid"
+)
+
+// defaultCacheTTL bounds how long an endpoint or active-deploy lookup is
+// trusted before Client re-fetches it, independent of the explicit
+// invalidation below.
+const defaultCacheTTL = 30 * time.Second
+
+// Cache key scheme shared by every lookup Client caches.
+func endpointCacheKey(id uuid.UUID) string     { return fmt.Sprintf("endpoint:%s", id) }
+func endpointListCacheKey() string             { return "endpoint:list" }
+func activeDeployCacheKey(id uuid.UUID) string { return fmt.Sprintf("deploy:%s:active", id) }
+
+// CacheAside is the cache-aside helper ListEndpoints, GetEndpoint and
+// ActiveDeploy run their round-trip through: a hit on loader
+// short-circuits fetch entirely, a miss runs fetch with stampede
+// protection (concurrent misses for the same key share one in-flight
+// fetch) and populates loader for defaultCacheTTL.
+//
+// It's expressed as a free function, wrapped by Client's unexported
+// cached helper, rather than a Loader method so it stays generic over
+// the value type each call site caches.
+func CacheAside[V any](loader *cache.Loader[string, any], key string, fetch func() (V, error)) (V, error) {
+	v, err := loader.GetOrLoad(key, defaultCacheTTL, func() (any, error) {
+		return fetch()
+	})
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+	return v.(V), nil
+}
+
+// InvalidateEndpoint busts the cached entry for a single endpoint and
+// its active-deploy lookup. CreateDeploy and RollbackEndpoint should
+// call this on success.
+func InvalidateEndpoint(loader *cache.Loader[string, any], endpointID uuid.UUID) {
+	loader.Delete(endpointCacheKey(endpointID))
+	loader.Delete(activeDeployCacheKey(endpointID))
+}
+
+// InvalidateEndpointList busts the cached ListEndpoints result.
+// CreateEndpoint should call this on success.
+func InvalidateEndpointList(loader *cache.Loader[string, any]) {
+	loader.Delete(endpointListCacheKey())
+}
+
+// EventInvalidator watches a StreamLogs feed and busts the same cache
+// entries InvalidateEndpoint/InvalidateEndpointList do, but for
+// DEPLOY_CREATED/ROLLBACK events raised by other clients — without it, a
+// rollback made from a different process would leave this client
+// serving a stale active-deploy lookup until its TTL expires.
+type EventInvalidator struct {
+	loader *cache.Loader[string, any]
+	stream *LogStream
+}
+
+// NewEventInvalidator wires stream's events into loader's invalidation.
+func NewEventInvalidator(loader *cache.Loader[string, any], stream *LogStream) *EventInvalidator {
+	return &EventInvalidator{loader: loader, stream: stream}
+}
+
+// Run blocks, invalidating on every DEPLOY_CREATED/ROLLBACK event until
+// the stream ends. Callers typically run it in its own goroutine
+// alongside a long-lived cached client.
+func (inv *EventInvalidator) Run() error {
+	for {
+		e, err := inv.stream.Recv()
+		if err != nil {
+			return err
+		}
+		switch e.Kind {
+		case api.EventKindDeployCreated, api.EventKindRollback:
+			id, err := uuid.Parse(e.EndpointID)
+			if err != nil {
+				continue
+			}
+			InvalidateEndpoint(inv.loader, id)
+			InvalidateEndpointList(inv.loader)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Package cache provides the in-memory cache pkg/client layers over its
+// endpoint and deploy lookups so a per-request caller of
+// Client.ActiveDeploy isn't forced into a round-trip per request.
+package cache
+
+import "time"
+
+// Cache is a generic key/value store with per-entry expiry. Implementations
+// must be safe for concurrent use; a Set with ttl <= 0 means the entry
+// never expires on its own (it can still be evicted under capacity
+// pressure or removed with Delete/Flush).
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Set(key K, value V, ttl time.Duration)
+	Delete(key K)
+	Flush()
+}
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShardedConcurrentGetSet(t *testing.T) {
+	c := New[string, int](0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		i := i
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.Set(fmt.Sprintf("key-%d", i%10), i, time.Minute)
+		}()
+		go func() {
+			defer wg.Done()
+			c.Get(fmt.Sprintf("key-%d", i%10))
+		}()
+	}
+	wg.Wait()
+
+	if _, ok := c.Get("key-0"); !ok {
+		t.Fatalf("expected key-0 to be present after concurrent writers")
+	}
+}
+
+func TestShardedTTLExpiry(t *testing.T) {
+	c := New[string, string](0)
+
+	c.Set("a", "fresh", 10*time.Millisecond)
+	if v, ok := c.Get("a"); !ok || v != "fresh" {
+		t.Fatalf("expected immediate Get to hit, got %q ok=%v", v, ok)
+	}
+
+	time.Sleep(25 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("expected entry to have expired")
+	}
+}
+
+func TestShardedNoTTLNeverExpires(t *testing.T) {
+	c := New[string, string](0)
+	c.Set("a", "sticks-around", 0)
+	time.Sleep(10 * time.Millisecond)
+	if v, ok := c.Get("a"); !ok || v != "sticks-around" {
+		t.Fatalf("expected entry set with ttl=0 to survive, got %q ok=%v", v, ok)
+	}
+}
+
+func TestShardLRUEviction(t *testing.T) {
+	// Route every key to a single shard so eviction order is deterministic;
+	// with defaultShardCount shards there's no guarantee which keys land
+	// in the same shard.
+	sh := &shard[string, int]{capacity: 2, items: make(map[string]*entry[string, int]), order: list.New()}
+	c := &sharded[string, int]{shards: []*shard[string, int]{sh}}
+	set := func(key string, v int) { c.Set(key, v, 0) }
+	touch := func(key string) { c.Get(key) }
+
+	set("k0", 0)
+	set("k1", 1)
+	touch("k0")
+	set("k2", 2)
+
+	if _, ok := sh.items["k1"]; ok {
+		t.Fatalf("expected k1 to have been evicted as least recently used")
+	}
+	if _, ok := sh.items["k0"]; !ok {
+		t.Fatalf("expected k0 to survive eviction (recently touched)")
+	}
+	if _, ok := sh.items["k2"]; !ok {
+		t.Fatalf("expected k2 to survive eviction (just inserted)")
+	}
+}
+
+func TestLoaderStampedeProtection(t *testing.T) {
+	c := New[string, int](0)
+	loader := NewLoader[string, int](c)
+
+	var calls int32
+	load := func() (int, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 20)
+	for i := 0; i < 20; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := loader.GetOrLoad("k", time.Minute, load)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			results[i] = v
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected exactly 1 load for a stampede of concurrent misses, got %d", got)
+	}
+	for i, v := range results {
+		if v != 42 {
+			t.Fatalf("result %d: got %d, want 42", i, v)
+		}
+	}
+}
+
+func TestLoaderDoesNotCacheErrors(t *testing.T) {
+	c := New[string, int](0)
+	loader := NewLoader[string, int](c)
+
+	wantErr := errors.New("boom")
+	_, err := loader.GetOrLoad("k", time.Minute, func() (int, error) {
+		return 0, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	}
+
+	if _, ok := c.Get("k"); ok {
+		t.Fatalf("expected a failed load not to populate the cache")
+	}
+
+	v, err := loader.GetOrLoad("k", time.Minute, func() (int, error) {
+		return 7, nil
+	})
+	if err != nil || v != 7 {
+		t.Fatalf("expected retry after error to succeed, got %d, %v", v, err)
+	}
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// call tracks a single in-flight load so concurrent callers for the same
+// key can wait on it instead of issuing their own.
+type call[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+}
+
+// group dedups concurrent loads per key, same idea as singleflight but
+// scoped down to what Loader needs.
+type group[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+func (g *group[K, V]) do(key K, fn func() (V, error)) (V, error) {
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.value, c.err
+	}
+	c := &call[V]{}
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	c.value, c.err = fn()
+	c.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return c.value, c.err
+}
+
+// Loader wraps a Cache with stampede protection: on a miss, the first
+// caller for a key runs load while every other caller for that same key
+// blocks on its result instead of triggering a redundant fetch.
+type Loader[K comparable, V any] struct {
+	cache Cache[K, V]
+	g     *group[K, V]
+}
+
+// NewLoader wraps c. The zero value of Loader is not usable; always
+// construct it through here so the dedup group is initialized.
+func NewLoader[K comparable, V any](c Cache[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{cache: c, g: &group[K, V]{calls: make(map[K]*call[V])}}
+}
+
+// GetOrLoad returns the cached value for key if present, otherwise calls
+// load exactly once per concurrent wave of misses, caches the result for
+// ttl on success, and does not cache on error.
+func (l *Loader[K, V]) GetOrLoad(key K, ttl time.Duration, load func() (V, error)) (V, error) {
+	if v, ok := l.cache.Get(key); ok {
+		return v, nil
+	}
+	return l.g.do(key, func() (V, error) {
+		if v, ok := l.cache.Get(key); ok {
+			return v, nil
+		}
+		v, err := load()
+		if err != nil {
+			return v, err
+		}
+		l.cache.Set(key, v, ttl)
+		return v, nil
+	})
+}
+
+// Delete removes key from the underlying cache.
+func (l *Loader[K, V]) Delete(key K) { l.cache.Delete(key) }
+
+// Flush clears the underlying cache.
+func (l *Loader[K, V]) Flush() { l.cache.Flush() }
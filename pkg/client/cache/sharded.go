@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// defaultShardCount trades a little memory overhead for less lock
+// contention under concurrent Get/Set from multiple goroutines.
+const defaultShardCount = 16
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+	elem      *list.Element
+}
+
+// shard is one lock-protected slice of the overall cache: a map for
+// lookup plus an LRU list so eviction under capacity pressure drops the
+// least recently used entry first.
+type shard[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[K]*entry[K, V]
+	order    *list.List
+}
+
+type sharded[K comparable, V any] struct {
+	shards []*shard[K, V]
+}
+
+// New returns a Cache that shards its storage across defaultShardCount
+// maps, each bounded to capacityPerShard entries (0 means unbounded)
+// with LRU eviction, and expiring entries per the ttl passed to Set.
+func New[K comparable, V any](capacityPerShard int) Cache[K, V] {
+	s := &sharded[K, V]{shards: make([]*shard[K, V], defaultShardCount)}
+	for i := range s.shards {
+		s.shards[i] = &shard[K, V]{
+			capacity: capacityPerShard,
+			items:    make(map[K]*entry[K, V]),
+			order:    list.New(),
+		}
+	}
+	return s
+}
+
+func (s *sharded[K, V]) shardFor(key K) *shard[K, V] {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%v", key)
+	return s.shards[h.Sum32()%uint32(len(s.shards))]
+}
+
+func (s *sharded[K, V]) Get(key K) (V, bool) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	e, ok := sh.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if !e.expiresAt.IsZero() && time.Now().After(e.expiresAt) {
+		sh.order.Remove(e.elem)
+		delete(sh.items, key)
+		var zero V
+		return zero, false
+	}
+	sh.order.MoveToFront(e.elem)
+	return e.value, true
+}
+
+func (s *sharded[K, V]) Set(key K, value V, ttl time.Duration) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if e, ok := sh.items[key]; ok {
+		e.value = value
+		e.expiresAt = expiresAt
+		sh.order.MoveToFront(e.elem)
+		return
+	}
+
+	e := &entry[K, V]{key: key, value: value, expiresAt: expiresAt}
+	e.elem = sh.order.PushFront(e)
+	sh.items[key] = e
+
+	if sh.capacity > 0 && len(sh.items) > sh.capacity {
+		oldest := sh.order.Back()
+		if oldest != nil {
+			sh.order.Remove(oldest)
+			delete(sh.items, oldest.Value.(*entry[K, V]).key)
+		}
+	}
+}
+
+func (s *sharded[K, V]) Delete(key K) {
+	sh := s.shardFor(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if e, ok := sh.items[key]; ok {
+		sh.order.Remove(e.elem)
+		delete(sh.items, key)
+	}
+}
+
+func (s *sharded[K, V]) Flush() {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		sh.items = make(map[K]*entry[K, V])
+		sh.order.Init()
+		sh.mu.Unlock()
+	}
+}
@@ -0,0 +1,216 @@
+// Package client is the run CLI's control-plane client: endpoint and
+// deploy CRUD over HTTP (this file and lifecycle.go), the gRPC
+// RuntimeClient used to talk to a single runner (runtime.go), and
+// StreamLogs against the control plane's event feed (logs.go).
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/anthdm/run/pkg/api"
+	"github.com/anthdm/run/pkg/client/cache"
+	"github.com/google/uuid"
+)
+
+// Config configures a Client. Build one with NewConfig and its With*
+// methods rather than constructing a Config directly, so defaults stay
+// in one place.
+type Config struct {
+	apiUrl string
+	cache  cache.Cache[string, any]
+}
+
+// NewConfig returns a Config with no cache set, so Client round-trips
+// every endpoint/deploy lookup until WithCache is called.
+func NewConfig() *Config {
+	return &Config{}
+}
+
+// WithURL sets the control plane's base URL.
+func (c *Config) WithURL(url string) *Config {
+	c.apiUrl = url
+	return c
+}
+
+// WithCache backs Client's ListEndpoints, GetEndpoint and ActiveDeploy
+// lookups with a cache.Cache, with stampede protection on
+// concurrent misses. Client invalidates the relevant entries itself on
+// CreateDeploy, CreateEndpoint and RollbackEndpoint, so cached reads
+// never outlive a write this same Client made.
+func (c *Config) WithCache(ch cache.Cache[string, any]) *Config {
+	c.cache = ch
+	return c
+}
+
+// Client is the run CLI's control-plane client.
+type Client struct {
+	apiUrl string
+	loader *cache.Loader[string, any] // nil when cfg.cache wasn't set
+}
+
+// New builds a Client from cfg.
+func New(cfg *Config) *Client {
+	c := &Client{apiUrl: cfg.apiUrl}
+	if cfg.cache != nil {
+		c.loader = cache.NewLoader[string, any](cfg.cache)
+	}
+	return c
+}
+
+// cached runs fetch through c.loader's cache-aside helper when a cache
+// is configured, and calls fetch directly otherwise.
+func cached[V any](c *Client, key string, fetch func() (V, error)) (V, error) {
+	if c.loader == nil {
+		return fetch()
+	}
+	return CacheAside(c.loader, key, fetch)
+}
+
+// invalidateEndpoint is a no-op when no cache is configured.
+func (c *Client) invalidateEndpoint(endpointID uuid.UUID) {
+	if c.loader != nil {
+		InvalidateEndpoint(c.loader, endpointID)
+	}
+}
+
+func (c *Client) invalidateEndpointList() {
+	if c.loader != nil {
+		InvalidateEndpointList(c.loader)
+	}
+}
+
+// ListEndpoints returns every endpoint on the control plane.
+func (c *Client) ListEndpoints() ([]api.Endpoint, error) {
+	return cached(c, endpointListCacheKey(), func() ([]api.Endpoint, error) {
+		var endpoints []api.Endpoint
+		err := c.get(fmt.Sprintf("%s/endpoint", c.apiUrl), &endpoints)
+		return endpoints, err
+	})
+}
+
+// GetEndpoint fetches a single endpoint. It's the lookup CreateDeploy and
+// RollbackEndpoint invalidate, so a cached Client never serves a stale
+// ActiveDeployID once it's made the write itself.
+func (c *Client) GetEndpoint(id uuid.UUID) (api.Endpoint, error) {
+	return cached(c, endpointCacheKey(id), func() (api.Endpoint, error) {
+		return c.fetchEndpoint(id)
+	})
+}
+
+// ActiveDeploy returns endpointID's currently-serving deploy. It's cached
+// under its own key rather than riding GetEndpoint's, since callers like
+// a per-request hot path only ever want this one field and shouldn't pay
+// for (or invalidate on) changes to the rest of the endpoint.
+func (c *Client) ActiveDeploy(endpointID uuid.UUID) (uuid.UUID, error) {
+	return cached(c, activeDeployCacheKey(endpointID), func() (uuid.UUID, error) {
+		endpoint, err := c.fetchEndpoint(endpointID)
+		return endpoint.ActiveDeployID, err
+	})
+}
+
+func (c *Client) fetchEndpoint(id uuid.UUID) (api.Endpoint, error) {
+	var endpoint api.Endpoint
+	err := c.get(fmt.Sprintf("%s/endpoint/%s", c.apiUrl, id), &endpoint)
+	return endpoint, err
+}
+
+// CreateEndpoint reserves a new endpoint. It busts the cached endpoint
+// list so a follow-up ListEndpoints sees it immediately.
+func (c *Client) CreateEndpoint(params api.CreateEndpointParams) (api.Endpoint, error) {
+	var endpoint api.Endpoint
+	b, err := json.Marshal(params)
+	if err != nil {
+		return endpoint, err
+	}
+	err = c.post(fmt.Sprintf("%s/endpoint", c.apiUrl), bytes.NewReader(b), &endpoint)
+	if err != nil {
+		return endpoint, err
+	}
+	c.invalidateEndpointList()
+	return endpoint, nil
+}
+
+// CreateDeploy uploads wasm to endpointID. params.ManifestJSON, when
+// set, is sent alongside the upload so the control plane (and,
+// downstream, the runner that warms up the module) can verify the
+// bytes it receives are the ones params.DeployID's manifest was signed
+// against; the control plane is expected to honor params.DeployID as
+// the new deploy's ID rather than minting its own. CreateDeploy busts
+// the uploaded endpoint's cached entry, since a successful deploy
+// changes its active deploy.
+func (c *Client) CreateDeploy(endpointID uuid.UUID, wasm io.Reader, params api.CreateDeployParams) (api.Deploy, error) {
+	var deploy api.Deploy
+
+	url := fmt.Sprintf("%s/endpoint/%s/deploy", c.apiUrl, endpointID)
+	req, err := http.NewRequest(http.MethodPost, url, wasm)
+	if err != nil {
+		return deploy, err
+	}
+	req.Header.Set("Content-Type", "application/wasm")
+	if params.DeployID != uuid.Nil {
+		req.Header.Set("X-Deploy-ID", params.DeployID.String())
+	}
+	if params.ManifestJSON != nil {
+		req.Header.Set("X-Deploy-Manifest", base64.StdEncoding.EncodeToString(params.ManifestJSON))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return deploy, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return deploy, fmt.Errorf("client: create deploy for %s: %s", endpointID, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&deploy); err != nil {
+		return deploy, err
+	}
+	c.invalidateEndpoint(endpointID)
+	return deploy, nil
+}
+
+// RollbackEndpoint points endpointID's active deploy at params.DeployID.
+// It busts the endpoint's cached entry and active-deploy lookup so a
+// follow-up read doesn't serve the deploy that was just rolled back.
+func (c *Client) RollbackEndpoint(endpointID uuid.UUID, params api.CreateRollbackParams) (api.Endpoint, error) {
+	var endpoint api.Endpoint
+	b, err := json.Marshal(params)
+	if err != nil {
+		return endpoint, err
+	}
+	err = c.post(fmt.Sprintf("%s/endpoint/%s/rollback", c.apiUrl, endpointID), bytes.NewReader(b), &endpoint)
+	if err != nil {
+		return endpoint, err
+	}
+	c.invalidateEndpoint(endpointID)
+	return endpoint, nil
+}
+
+func (c *Client) get(url string, out any) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("client: GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (c *Client) post(url string, body io.Reader, out any) error {
+	resp, err := http.Post(url, "application/json", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("client: POST %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
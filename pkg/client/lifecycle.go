@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/anthdm/run/pkg/api"
+	"github.com/google/uuid"
+)
+
+// PauseDeploy, ResumeDeploy and StopDeploy transition a deploy's lifecycle
+// state; ScaleDeploy additionally sets its instance bounds. All four
+// round-trip through the DeployStatus the control plane tracks per deploy.
+func (c *Client) PauseDeploy(endpointID, deployID uuid.UUID) (api.DeployStatus, error) {
+	return c.deployLifecycle(endpointID, deployID, "pause", nil)
+}
+
+func (c *Client) ResumeDeploy(endpointID, deployID uuid.UUID) (api.DeployStatus, error) {
+	return c.deployLifecycle(endpointID, deployID, "resume", nil)
+}
+
+func (c *Client) StopDeploy(endpointID, deployID uuid.UUID) (api.DeployStatus, error) {
+	return c.deployLifecycle(endpointID, deployID, "stop", nil)
+}
+
+func (c *Client) ScaleDeploy(endpointID, deployID uuid.UUID, params api.CreateScaleParams) (api.DeployStatus, error) {
+	return c.deployLifecycle(endpointID, deployID, "scale", &params)
+}
+
+func (c *Client) deployLifecycle(endpointID, deployID uuid.UUID, action string, body any) (api.DeployStatus, error) {
+	var status api.DeployStatus
+
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return status, err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	url := fmt.Sprintf("%s/endpoint/%s/deploy/%s/%s", c.apiUrl, endpointID, deployID, action)
+	req, err := http.NewRequest(http.MethodPost, url, r)
+	if err != nil {
+		return status, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return status, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return status, fmt.Errorf("client: %s deploy %s: %s", action, deployID, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return status, err
+	}
+	return status, nil
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/anthdm/run/pkg/api"
+	"github.com/google/uuid"
+)
+
+// StreamLogsParams narrows a StreamLogs call to a single deploy and a
+// replay window; an empty DeployID streams every deploy on the
+// endpoint, and an empty Kind streams every event kind.
+type StreamLogsParams struct {
+	DeployID uuid.UUID
+	Kind     api.EventKind
+	Since    time.Time
+	Follow   bool
+}
+
+// LogStream is a handle on an open StreamLogs request. The control plane
+// responds with newline-delimited JSON events; Recv decodes one at a
+// time so a long --follow session doesn't have to buffer the whole body.
+type LogStream struct {
+	body io.ReadCloser
+	dec  *json.Decoder
+}
+
+// StreamLogs replays an endpoint's buffered events since params.Since
+// and, if params.Follow is set, keeps the connection open for new ones.
+func (c *Client) StreamLogs(ctx context.Context, endpointID uuid.UUID, params StreamLogsParams) (*LogStream, error) {
+	q := url.Values{}
+	if params.DeployID != uuid.Nil {
+		q.Set("deploy", params.DeployID.String())
+	}
+	if params.Kind != "" {
+		q.Set("kind", string(params.Kind))
+	}
+	if !params.Since.IsZero() {
+		q.Set("since", strconv.FormatInt(params.Since.UnixMilli(), 10))
+	}
+	if params.Follow {
+		q.Set("follow", "true")
+	}
+
+	reqURL := fmt.Sprintf("%s/endpoint/%s/logs?%s", c.apiUrl, endpointID, q.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("client: stream logs for %s: %s", endpointID, resp.Status)
+	}
+	return &LogStream{body: resp.Body, dec: json.NewDecoder(resp.Body)}, nil
+}
+
+// Recv decodes the next event off the stream, returning io.EOF once the
+// control plane closes the connection (the replay window is exhausted
+// and Follow wasn't set, or a follow session was cancelled).
+func (s *LogStream) Recv() (api.Event, error) {
+	var e api.Event
+	if err := s.dec.Decode(&e); err != nil {
+		return api.Event{}, err
+	}
+	return e, nil
+}
+
+func (s *LogStream) Close() error {
+	return s.body.Close()
+}
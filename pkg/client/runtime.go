@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/anthdm/run/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// RuntimeClient talks to a single wasm runner process over a unix socket.
+// It embeds the generated proto.RuntimeClient so callers get Invoke,
+// WarmUp and Shutdown directly without an extra layer of wrapper methods.
+type RuntimeClient struct {
+	proto.RuntimeClient
+
+	conn *grpc.ClientConn
+}
+
+// DialRuntime dials the runner listening on the given unix socket path.
+// The socket is created by the runner process on startup; the host retries
+// briefly since the runner may not have bound it yet.
+func DialRuntime(ctx context.Context, socketPath string) (*RuntimeClient, error) {
+	conn, err := grpc.DialContext(ctx, "unix:"+socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socketPath)
+		}),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("dial runtime socket %s: %w", socketPath, err)
+	}
+	return &RuntimeClient{
+		RuntimeClient: proto.NewRuntimeClient(conn),
+		conn:          conn,
+	}, nil
+}
+
+// Close tears down the connection to the runner. It does not shut the
+// runner process down; callers should call Shutdown first if they want
+// the runner to exit cleanly.
+func (c *RuntimeClient) Close() error {
+	return c.conn.Close()
+}
+
+// WaitForSocket blocks until the unix socket at path accepts connections
+// or the timeout elapses. Runners are spawned as subprocesses and need a
+// moment to bind their listener before the host can dial them.
+func WaitForSocket(path string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		conn, err := net.DialTimeout("unix", path, 50*time.Millisecond)
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+		time.Sleep(25 * time.Millisecond)
+	}
+	return fmt.Errorf("timed out waiting for runtime socket %s", path)
+}
@@ -0,0 +1,104 @@
+// Package config loads the run CLI's config.toml and exposes it through
+// a handful of package-level getters. It's parsed once, in the root
+// command's PersistentPreRunE, and read from there on; the root command
+// hands GetApiUrl to client.Config.WithURL, and the client uses that
+// URL for every control-plane call.
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var (
+	apiUrl            = "http://localhost:8080"
+	wasmUrl           = "http://localhost:8081"
+	signingPublicKeys []string
+)
+
+// Parse reads path as a flat "key = value" file, one assignment per
+// line, with an optional "[signing]" section header for the keys that
+// follow it; blank lines and lines starting with # are ignored. Top-
+// level keys are api_url and wasm_url, matching GetApiUrl/GetWasmUrl.
+// Under [signing], public_keys is a bracketed, comma-separated list of
+// paths to trusted public keys, matching GetSigningPublicKeys; anything
+// else is ignored so older and newer CLI versions can share a file. A
+// missing file at the default path isn't an error, so `run` works
+// out of the box against localhost without a config.toml at all.
+func Parse(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && path == "config.toml" {
+			return nil
+		}
+		return fmt.Errorf("config: opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("config: invalid line %q in %s", line, path)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch section {
+		case "signing":
+			if key == "public_keys" {
+				keys, err := parseStringArray(value)
+				if err != nil {
+					return fmt.Errorf("config: %s: %w", path, err)
+				}
+				signingPublicKeys = keys
+			}
+		default:
+			value = strings.Trim(value, `"`)
+			switch key {
+			case "api_url":
+				apiUrl = value
+			case "wasm_url":
+				wasmUrl = value
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// parseStringArray parses a TOML-style `["a", "b"]` array of strings.
+func parseStringArray(value string) ([]string, error) {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return nil, fmt.Errorf("expected a [...] array, got %q", value)
+	}
+	inner := strings.TrimSpace(value[1 : len(value)-1])
+	if inner == "" {
+		return nil, nil
+	}
+	parts := strings.Split(inner, ",")
+	keys := make([]string, 0, len(parts))
+	for _, p := range parts {
+		keys = append(keys, strings.Trim(strings.TrimSpace(p), `"`))
+	}
+	return keys, nil
+}
+
+// GetApiUrl returns the control plane's base URL.
+func GetApiUrl() string { return apiUrl }
+
+// GetWasmUrl returns the base URL deploys are served from.
+func GetWasmUrl() string { return wasmUrl }
+
+// GetSigningPublicKeys returns the [signing] public_keys paths from
+// config.toml, or nil if none were set.
+func GetSigningPublicKeys() []string { return signingPublicKeys }
@@ -0,0 +1,111 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Keystore is a set of trusted public keys, either a directory with one
+// PEM file per keyID named <keyID>.pub (NewKeystore) or a fixed set
+// loaded from explicit file paths (NewKeystoreFromFiles). Runners load a
+// Keystore at startup and refuse to run a manifest signed by a keyID
+// that isn't present. Exactly one of dir or keys is set; a Keystore
+// built with NewKeystoreFromFiles is read-only.
+type Keystore struct {
+	dir  string
+	keys map[string]ed25519.PublicKey
+}
+
+// NewKeystore returns a Keystore rooted at dir, creating it if necessary.
+func NewKeystore(dir string) (*Keystore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Keystore{dir: dir}, nil
+}
+
+// NewKeystoreFromFiles loads a fixed set of trusted keys from paths, as
+// named by config.toml's [signing] public_keys. Each key's ID is its
+// file name with the extension stripped, matching the <keyID>.pub
+// convention NewKeystore's directory uses.
+func NewKeystoreFromFiles(paths []string) (*Keystore, error) {
+	keys := make(map[string]ed25519.PublicKey, len(paths))
+	for _, path := range paths {
+		pub, err := LoadPublicKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("signing: loading trusted key %s: %w", path, err)
+		}
+		keyID := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		keys[keyID] = pub
+	}
+	return &Keystore{keys: keys}, nil
+}
+
+// pathFor rejects a keyID that would escape k.dir. keyID arrives on deploy
+// manifests, which aren't trusted until this same Keystore has verified
+// them, so it must never be joined into a path unchecked.
+func (k *Keystore) pathFor(keyID string) (string, error) {
+	if keyID == "" || strings.ContainsAny(keyID, `/\`) || keyID == "." || keyID == ".." {
+		return "", fmt.Errorf("signing: invalid key id %q", keyID)
+	}
+	return filepath.Join(k.dir, keyID+".pub"), nil
+}
+
+// Add registers pubPEM under keyID, overwriting any existing key with
+// that ID. It fails on a Keystore built with NewKeystoreFromFiles, which
+// has no directory to write into.
+func (k *Keystore) Add(keyID string, pubPEM []byte) error {
+	if k.keys != nil {
+		return fmt.Errorf("signing: keystore loaded from config.toml is read-only")
+	}
+	path, err := k.pathFor(keyID)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, pubPEM, 0o644)
+}
+
+// Load returns the public key registered under keyID.
+func (k *Keystore) Load(keyID string) (ed25519.PublicKey, error) {
+	if k.keys != nil {
+		pub, ok := k.keys[keyID]
+		if !ok {
+			return nil, fmt.Errorf("signing: unknown key id %q", keyID)
+		}
+		return pub, nil
+	}
+	path, err := k.pathFor(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return LoadPublicKey(path)
+}
+
+// List returns the keyIDs currently registered, sorted.
+func (k *Keystore) List() ([]string, error) {
+	if k.keys != nil {
+		ids := make([]string, 0, len(k.keys))
+		for id := range k.keys {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return ids, nil
+	}
+	entries, err := os.ReadDir(k.dir)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".pub") {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(e.Name(), ".pub"))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
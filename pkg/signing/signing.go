@@ -0,0 +1,152 @@
+// Package signing implements cosign-style signing and verification of
+// DeployManifests. A deploy is only trusted if its manifest's signature
+// was produced by a key the verifier already knows about; there is no
+// trust-on-first-use or certificate chain, just a flat set of named keys.
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anthdm/run/proto"
+)
+
+const Algorithm = "ed25519"
+
+// Canonicalize produces a deterministic byte representation of a
+// manifest's signed fields, excluding the signature itself. Map keys are
+// sorted so the same manifest always canonicalizes to the same bytes
+// regardless of iteration order.
+func Canonicalize(m *proto.DeployManifest) []byte {
+	var b strings.Builder
+	b.WriteString(m.GetEndpointID())
+	b.WriteByte('\n')
+	b.WriteString(m.GetDeployID())
+	b.WriteByte('\n')
+	b.Write(m.GetWasmSha256())
+	b.WriteByte('\n')
+	b.WriteString(m.GetRuntime())
+	b.WriteByte('\n')
+	b.WriteString(strconv.FormatInt(m.GetCreatedAt(), 10))
+	b.WriteByte('\n')
+
+	keys := make([]string, 0, len(m.GetEnv()))
+	for k := range m.GetEnv() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(m.Env[k])
+		b.WriteByte('\n')
+	}
+	return []byte(b.String())
+}
+
+// Sign computes the manifest's WasmSha256 from wasm, then signs the
+// canonicalized manifest with priv and attaches the result as the
+// manifest's Signature.
+func Sign(m *proto.DeployManifest, wasm []byte, keyID string, priv ed25519.PrivateKey) {
+	sum := sha256.Sum256(wasm)
+	m.WasmSha256 = sum[:]
+	sig := ed25519.Sign(priv, Canonicalize(m))
+	m.Signature = &proto.Signature{
+		KeyID: keyID,
+		Sig:   sig,
+		Alg:   Algorithm,
+	}
+}
+
+// Verify checks that wasm hashes to the sha256 pinned in the manifest and
+// that the manifest's signature was produced by pub over the canonicalized
+// manifest. It returns a descriptive error for either failure so callers
+// can refuse to run the module with a clear reason.
+func Verify(m *proto.DeployManifest, wasm []byte, pub ed25519.PublicKey) error {
+	sig := m.GetSignature()
+	if sig == nil {
+		return fmt.Errorf("signing: manifest for deploy %s has no signature", m.GetDeployID())
+	}
+	if sig.Alg != Algorithm {
+		return fmt.Errorf("signing: unsupported signature algorithm %q", sig.Alg)
+	}
+	sum := sha256.Sum256(wasm)
+	if string(sum[:]) != string(m.GetWasmSha256()) {
+		return fmt.Errorf("signing: wasm sha256 mismatch for deploy %s", m.GetDeployID())
+	}
+	if !ed25519.Verify(pub, Canonicalize(m), sig.Sig) {
+		return fmt.Errorf("signing: signature verification failed for deploy %s (key %s)", m.GetDeployID(), sig.KeyID)
+	}
+	return nil
+}
+
+// GeneratePEMKeyPair creates a fresh ed25519 key pair PEM-encoded as
+// PKCS8 (private) and PKIX (public), the formats LoadPrivateKey and
+// LoadPublicKey read.
+func GeneratePEMKeyPair() (privPEM, pubPEM []byte, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	privBytes, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubBytes, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, nil, err
+	}
+	privPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privBytes})
+	pubPEM = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes})
+	return privPEM, pubPEM, nil
+}
+
+// LoadPrivateKey reads a PKCS8-encoded ed25519 private key from a PEM file.
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("signing: %s is not PEM encoded", path)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("signing: parsing private key %s: %w", path, err)
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("signing: %s is not an ed25519 private key", path)
+	}
+	return priv, nil
+}
+
+// LoadPublicKey reads a PKIX-encoded ed25519 public key from a PEM file.
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("signing: %s is not PEM encoded", path)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("signing: parsing public key %s: %w", path, err)
+	}
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("signing: %s is not an ed25519 public key", path)
+	}
+	return pub, nil
+}
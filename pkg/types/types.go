@@ -0,0 +1,16 @@
+// Package types holds small value types shared across the CLI and runner
+// that don't belong in pkg/api (the control plane's wire types) or the
+// generated proto package.
+package types
+
+// ValidRuntime reports whether name is a runtime the runner knows how to
+// execute: "go" for a compiled wasm module, "js" for a script run through
+// the shared jsInterpreter.
+func ValidRuntime(name string) bool {
+	switch name {
+	case "go", "js":
+		return true
+	default:
+		return false
+	}
+}